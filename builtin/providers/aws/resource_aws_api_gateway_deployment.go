@@ -12,6 +12,10 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// resourceAwsApiGatewayDeployment creates an immutable snapshot of a REST API.
+// Stage configuration is better managed with the dedicated aws_api_gateway_stage
+// resource, which can be updated in place; stage_description and variables set
+// here only take effect when the deployment first creates its stage.
 func resourceAwsApiGatewayDeployment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsApiGatewayDeploymentCreate,
@@ -38,17 +42,47 @@ func resourceAwsApiGatewayDeployment() *schema.Resource {
 			},
 
 			"stage_description": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				ForceNew:   true,
+				Deprecated: "Use the description argument on aws_api_gateway_stage instead; a stage created implicitly by this resource no longer has an independent description once you manage it with aws_api_gateway_stage.",
 			},
 
 			"variables": &schema.Schema{
+				Type:       schema.TypeMap,
+				Optional:   true,
+				ForceNew:   true,
+				Elem:       schema.TypeString,
+				Deprecated: "Use the variables argument on aws_api_gateway_stage instead; stage variables set here are only applied the first time the stage is created.",
+			},
+
+			"triggers": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
 				ForceNew: true,
 				Elem:     schema.TypeString,
 			},
+
+			"skip_stage_deletion": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"invoke_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"execution_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -99,6 +133,19 @@ func resourceAwsApiGatewayDeploymentRead(d *schema.ResourceData, meta interface{
 	log.Printf("[DEBUG] Received API Gateway Deployment: %s", out)
 	d.SetId(*out.Id)
 	d.Set("description", out.Description)
+	if out.CreatedDate != nil {
+		d.Set("created_date", out.CreatedDate.Format(time.RFC3339))
+	}
+
+	region := meta.(*AWSClient).region
+	restApiId := d.Get("rest_api_id").(string)
+	stageName := d.Get("stage_name").(string)
+
+	d.Set("invoke_url", fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com/%s", restApiId, region, stageName))
+
+	client := meta.(*AWSClient)
+	executionArn := fmt.Sprintf("arn:%s:execute-api:%s:%s:%s/%s", client.partition, region, client.accountid, restApiId, stageName)
+	d.Set("execution_arn", executionArn)
 
 	return nil
 }
@@ -122,10 +169,17 @@ func resourceAwsApiGatewayDeploymentUpdate(d *schema.ResourceData, meta interfac
 
 	log.Printf("[DEBUG] Updating API Gateway API Key: %s", d.Id())
 
+	operations := resourceAwsApiGatewayDeploymentUpdateOperations(d)
+	if len(operations) == 0 {
+		// Only skip_stage_deletion changed, which isn't reflected in the
+		// deployment itself, so there's nothing to PATCH.
+		return resourceAwsApiGatewayDeploymentRead(d, meta)
+	}
+
 	_, err := conn.UpdateDeployment(&apigateway.UpdateDeploymentInput{
 		DeploymentId:    aws.String(d.Id()),
 		RestApiId:       aws.String(d.Get("rest_api_id").(string)),
-		PatchOperations: resourceAwsApiGatewayDeploymentUpdateOperations(d),
+		PatchOperations: operations,
 	})
 	if err != nil {
 		return err
@@ -134,19 +188,52 @@ func resourceAwsApiGatewayDeploymentUpdate(d *schema.ResourceData, meta interfac
 	return resourceAwsApiGatewayDeploymentRead(d, meta)
 }
 
+// apiGatewayIsRetryableError returns true for API Gateway error codes that are
+// expected to clear up on their own, such as API Gateway's per-account request
+// throttle or a stage/deployment still being referenced by an in-flight change.
+func apiGatewayIsRetryableError(code string) bool {
+	switch code {
+	case "TooManyRequestsException", "ConflictException":
+		return true
+	default:
+		return false
+	}
+}
+
 func resourceAwsApiGatewayDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 	log.Printf("[DEBUG] Deleting API Gateway Deployment: %s", d.Id())
 
-	return resource.Retry(5*time.Minute, func() error {
-		log.Printf("[DEBUG] schema is %#v", d)
-		if _, err := conn.DeleteStage(&apigateway.DeleteStageInput{
-			StageName: aws.String(d.Get("stage_name").(string)),
-			RestApiId: aws.String(d.Get("rest_api_id").(string)),
-		}); err == nil {
-			return nil
+	stageName := d.Get("stage_name").(string)
+
+	if !d.Get("skip_stage_deletion").(bool) && stageName != "" {
+		err := resource.Retry(5*time.Minute, func() error {
+			_, err := conn.DeleteStage(&apigateway.DeleteStageInput{
+				StageName: aws.String(stageName),
+				RestApiId: aws.String(d.Get("rest_api_id").(string)),
+			})
+			if err == nil {
+				return nil
+			}
+
+			awsErr, ok := err.(awserr.Error)
+			if ok && awsErr.Code() == "NotFoundException" {
+				return nil
+			}
+
+			// A plain error is retried; resource.RetryError stops the retry loop.
+			if ok && apiGatewayIsRetryableError(awsErr.Code()) {
+				return err
+			}
+
+			return resource.RetryError{Err: err}
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting API Gateway Stage: %s", err)
 		}
+	}
 
+	return resource.Retry(5*time.Minute, func() error {
 		_, err := conn.DeleteDeployment(&apigateway.DeleteDeploymentInput{
 			DeploymentId: aws.String(d.Id()),
 			RestApiId:    aws.String(d.Get("rest_api_id").(string)),
@@ -155,15 +242,15 @@ func resourceAwsApiGatewayDeploymentDelete(d *schema.ResourceData, meta interfac
 			return nil
 		}
 
-		apigatewayErr, ok := err.(awserr.Error)
-		if apigatewayErr.Code() == "NotFoundException" {
+		awsErr, ok := err.(awserr.Error)
+		if ok && awsErr.Code() == "NotFoundException" {
 			return nil
 		}
 
-		if !ok {
-			return resource.RetryError{Err: err}
+		if ok && apiGatewayIsRetryableError(awsErr.Code()) {
+			return err
 		}
 
 		return resource.RetryError{Err: err}
 	})
-}
\ No newline at end of file
+}
@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestApiGatewayIsRetryableError(t *testing.T) {
+	retryableCodes := []string{"TooManyRequestsException", "ConflictException"}
+	for _, code := range retryableCodes {
+		if !apiGatewayIsRetryableError(code) {
+			t.Errorf("expected %q to be retryable", code)
+		}
+	}
+
+	nonRetryableCodes := []string{"NotFoundException", "BadRequestException", ""}
+	for _, code := range nonRetryableCodes {
+		if apiGatewayIsRetryableError(code) {
+			t.Errorf("expected %q to not be retryable", code)
+		}
+	}
+}
+
+func TestResourceAwsApiGatewayDeploymentUpdateOperations_skipStageDeletionOnly(t *testing.T) {
+	raw := map[string]interface{}{
+		"rest_api_id":         "test",
+		"stage_name":          "test",
+		"skip_stage_deletion": true,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAwsApiGatewayDeployment().Schema, raw)
+
+	if !d.HasChange("skip_stage_deletion") {
+		t.Fatal("expected skip_stage_deletion to have changed")
+	}
+
+	ops := resourceAwsApiGatewayDeploymentUpdateOperations(d)
+	if len(ops) != 0 {
+		t.Errorf("expected no patch operations when only skip_stage_deletion changes, got %#v", ops)
+	}
+}
+
+func TestAccAWSAPIGatewayDeployment_basic(t *testing.T) {
+	var deployment apigateway.Deployment
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayDeploymentConfig(rName, "trigger one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayDeploymentExists("aws_api_gateway_deployment.test", &deployment),
+					resource.TestCheckResourceAttrSet("aws_api_gateway_deployment.test", "invoke_url"),
+					resource.TestCheckResourceAttrSet("aws_api_gateway_deployment.test", "execution_arn"),
+					resource.TestCheckResourceAttrSet("aws_api_gateway_deployment.test", "created_date"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayDeploymentConfig(rName, "trigger two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayDeploymentRecreated(t, &deployment),
+					testAccCheckAWSAPIGatewayDeploymentExists("aws_api_gateway_deployment.test", &deployment),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayDeploymentRecreated(t *testing.T, previous *apigateway.Deployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var current apigateway.Deployment
+		if err := testAccCheckAWSAPIGatewayDeploymentExists("aws_api_gateway_deployment.test", &current)(s); err != nil {
+			return err
+		}
+
+		if aws.StringValue(previous.Id) == aws.StringValue(current.Id) {
+			return fmt.Errorf("Expected a new API Gateway Deployment to be created when triggers changed, got the same ID: %s", aws.StringValue(current.Id))
+		}
+
+		*previous = current
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAPIGatewayDeploymentExists(n string, res *apigateway.Deployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway Deployment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigateway
+
+		out, err := conn.GetDeployment(&apigateway.GetDeploymentInput{
+			RestApiId:    aws.String(rs.Primary.Attributes["rest_api_id"]),
+			DeploymentId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*res = *out
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAPIGatewayDeploymentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigateway
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_api_gateway_deployment" {
+			continue
+		}
+
+		_, err := conn.GetDeployment(&apigateway.GetDeploymentInput{
+			RestApiId:    aws.String(rs.Primary.Attributes["rest_api_id"]),
+			DeploymentId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("API Gateway Deployment still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAPIGatewayDeploymentConfig(rName, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = "%s"
+}
+
+resource "aws_api_gateway_resource" "test" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  parent_id   = "${aws_api_gateway_rest_api.test.root_resource_id}"
+  path_part   = "test"
+}
+
+resource "aws_api_gateway_method" "test" {
+  rest_api_id   = "${aws_api_gateway_rest_api.test.id}"
+  resource_id   = "${aws_api_gateway_resource.test.id}"
+  http_method   = "GET"
+  authorization = "NONE"
+}
+
+resource "aws_api_gateway_integration" "test" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  resource_id = "${aws_api_gateway_resource.test.id}"
+  http_method = "${aws_api_gateway_method.test.http_method}"
+  type        = "MOCK"
+}
+
+resource "aws_api_gateway_deployment" "test" {
+  depends_on  = ["aws_api_gateway_integration.test"]
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  stage_name  = "prod"
+
+  triggers {
+    redeployment = "%s"
+  }
+}
+`, rName, trigger)
+}
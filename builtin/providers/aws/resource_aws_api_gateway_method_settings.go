@@ -0,0 +1,266 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsApiGatewayMethodSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayMethodSettingsCreate,
+		Read:   resourceAwsApiGatewayMethodSettingsRead,
+		Update: resourceAwsApiGatewayMethodSettingsUpdate,
+		Delete: resourceAwsApiGatewayMethodSettingsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"rest_api_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stage_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"method_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"settings": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metrics_enabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"logging_level": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "OFF",
+						},
+
+						"data_trace_enabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"throttling_burst_limit": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"throttling_rate_limit": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+
+						"caching_enabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"cache_ttl_in_seconds": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"cache_data_encrypted": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"require_authorization_for_cache_control": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"unauthorized_cache_control_header_strategy": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "SUCCEED_WITHOUT_RESPONSE_HEADER",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayMethodSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	restApiId := d.Get("rest_api_id").(string)
+	stageName := d.Get("stage_name").(string)
+	methodPath := d.Get("method_path").(string)
+
+	d.SetId(fmt.Sprintf("%s-%s-%s", restApiId, stageName, methodPath))
+
+	return resourceAwsApiGatewayMethodSettingsUpdate(d, meta)
+}
+
+func resourceAwsApiGatewayMethodSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	restApiId := d.Get("rest_api_id").(string)
+	stageName := d.Get("stage_name").(string)
+	methodPath := d.Get("method_path").(string)
+
+	log.Printf("[DEBUG] Reading API Gateway Method Settings %s/%s/%s", restApiId, stageName, methodPath)
+	stage, err := conn.GetStage(&apigateway.GetStageInput{
+		RestApiId: aws.String(restApiId),
+		StageName: aws.String(stageName),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NotFoundException" {
+			log.Printf("[WARN] API Gateway Stage %s/%s not found, removing method settings from state", restApiId, stageName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	settings, ok := stage.MethodSettings[methodPath]
+	if !ok {
+		log.Printf("[WARN] API Gateway Method Settings %s not found, removing from state", methodPath)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("settings", flattenApiGatewayMethodSettings(settings))
+
+	return nil
+}
+
+func flattenApiGatewayMethodSettings(settings *apigateway.MethodSetting) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"metrics_enabled":                            aws.BoolValue(settings.MetricsEnabled),
+			"logging_level":                              aws.StringValue(settings.LoggingLevel),
+			"data_trace_enabled":                         aws.BoolValue(settings.DataTraceEnabled),
+			"throttling_burst_limit":                     int(aws.Int64Value(settings.ThrottlingBurstLimit)),
+			"throttling_rate_limit":                      aws.Float64Value(settings.ThrottlingRateLimit),
+			"caching_enabled":                            aws.BoolValue(settings.CachingEnabled),
+			"cache_ttl_in_seconds":                       int(aws.Int64Value(settings.CacheTtlInSeconds)),
+			"cache_data_encrypted":                       aws.BoolValue(settings.CacheDataEncrypted),
+			"require_authorization_for_cache_control":    aws.BoolValue(settings.RequireAuthorizationForCacheControl),
+			"unauthorized_cache_control_header_strategy": aws.StringValue(settings.UnauthorizedCacheControlHeaderStrategy),
+		},
+	}
+}
+
+func resourceAwsApiGatewayMethodSettingsUpdateOperations(d *schema.ResourceData) []*apigateway.PatchOperation {
+	operations := make([]*apigateway.PatchOperation, 0)
+	prefix := fmt.Sprintf("/%s/", d.Get("method_path").(string))
+
+	settings := d.Get("settings").([]interface{})[0].(map[string]interface{})
+
+	operations = append(operations,
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "metrics/enabled"),
+			Value: aws.String(fmt.Sprintf("%t", settings["metrics_enabled"].(bool))),
+		},
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "logging/loglevel"),
+			Value: aws.String(settings["logging_level"].(string)),
+		},
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "logging/dataTrace"),
+			Value: aws.String(fmt.Sprintf("%t", settings["data_trace_enabled"].(bool))),
+		},
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "throttling/burstLimit"),
+			Value: aws.String(fmt.Sprintf("%d", settings["throttling_burst_limit"].(int))),
+		},
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "throttling/rateLimit"),
+			Value: aws.String(fmt.Sprintf("%f", settings["throttling_rate_limit"].(float64))),
+		},
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "caching/enabled"),
+			Value: aws.String(fmt.Sprintf("%t", settings["caching_enabled"].(bool))),
+		},
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "caching/ttlInSeconds"),
+			Value: aws.String(fmt.Sprintf("%d", settings["cache_ttl_in_seconds"].(int))),
+		},
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "caching/dataEncrypted"),
+			Value: aws.String(fmt.Sprintf("%t", settings["cache_data_encrypted"].(bool))),
+		},
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "caching/requireAuthorizationForCacheControl"),
+			Value: aws.String(fmt.Sprintf("%t", settings["require_authorization_for_cache_control"].(bool))),
+		},
+		&apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(prefix + "caching/unauthorizedCacheControlHeaderStrategy"),
+			Value: aws.String(settings["unauthorized_cache_control_header_strategy"].(string)),
+		},
+	)
+
+	return operations
+}
+
+func resourceAwsApiGatewayMethodSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	log.Printf("[DEBUG] Updating API Gateway Method Settings: %s", d.Id())
+
+	_, err := conn.UpdateStage(&apigateway.UpdateStageInput{
+		RestApiId:       aws.String(d.Get("rest_api_id").(string)),
+		StageName:       aws.String(d.Get("stage_name").(string)),
+		PatchOperations: resourceAwsApiGatewayMethodSettingsUpdateOperations(d),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating API Gateway Method Settings: %s", err)
+	}
+
+	return resourceAwsApiGatewayMethodSettingsRead(d, meta)
+}
+
+func resourceAwsApiGatewayMethodSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+	log.Printf("[DEBUG] Deleting API Gateway Method Settings: %s", d.Id())
+
+	path := fmt.Sprintf("/%s", d.Get("method_path").(string))
+
+	_, err := conn.UpdateStage(&apigateway.UpdateStageInput{
+		RestApiId: aws.String(d.Get("rest_api_id").(string)),
+		StageName: aws.String(d.Get("stage_name").(string)),
+		PatchOperations: []*apigateway.PatchOperation{
+			{
+				Op:   aws.String("remove"),
+				Path: aws.String(path),
+			},
+		},
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NotFoundException" {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
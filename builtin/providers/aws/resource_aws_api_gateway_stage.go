@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsApiGatewayStage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayStageCreate,
+		Read:   resourceAwsApiGatewayStageRead,
+		Update: resourceAwsApiGatewayStageUpdate,
+		Delete: resourceAwsApiGatewayStageDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayStageImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rest_api_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stage_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"deployment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"cache_cluster_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"cache_cluster_size": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"client_certificate_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"documentation_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"variables": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     schema.TypeString,
+			},
+
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayStageCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	variables := make(map[string]string)
+	for k, v := range d.Get("variables").(map[string]interface{}) {
+		variables[k] = v.(string)
+	}
+
+	tags := make(map[string]string)
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		tags[k] = v.(string)
+	}
+
+	restApiId := d.Get("rest_api_id").(string)
+	stageName := d.Get("stage_name").(string)
+
+	log.Printf("[DEBUG] Creating API Gateway Stage: %s/%s", restApiId, stageName)
+
+	input := &apigateway.CreateStageInput{
+		RestApiId:           aws.String(restApiId),
+		StageName:           aws.String(stageName),
+		DeploymentId:        aws.String(d.Get("deployment_id").(string)),
+		CacheClusterEnabled: aws.Bool(d.Get("cache_cluster_enabled").(bool)),
+		Description:         aws.String(d.Get("description").(string)),
+		Variables:           aws.StringMap(variables),
+		Tags:                aws.StringMap(tags),
+	}
+
+	if v, ok := d.GetOk("cache_cluster_size"); ok {
+		input.CacheClusterSize = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("client_certificate_id"); ok {
+		input.ClientCertificateId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("documentation_version"); ok {
+		input.DocumentationVersion = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateStage(input)
+	if err != nil {
+		return fmt.Errorf("Error creating API Gateway Stage: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", restApiId, stageName))
+
+	return resourceAwsApiGatewayStageRead(d, meta)
+}
+
+func resourceAwsApiGatewayStageImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Expected ID in the form of REST-API-ID/STAGE-NAME, given: %s", d.Id())
+	}
+
+	d.Set("rest_api_id", parts[0])
+	d.Set("stage_name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsApiGatewayStageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	restApiId := d.Get("rest_api_id").(string)
+	stageName := d.Get("stage_name").(string)
+
+	log.Printf("[DEBUG] Reading API Gateway Stage %s/%s", restApiId, stageName)
+	stage, err := conn.GetStage(&apigateway.GetStageInput{
+		RestApiId: aws.String(restApiId),
+		StageName: aws.String(stageName),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NotFoundException" {
+			log.Printf("[WARN] API Gateway Stage %s/%s not found, removing from state", restApiId, stageName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	log.Printf("[DEBUG] Received API Gateway Stage: %s", stage)
+
+	d.Set("deployment_id", stage.DeploymentId)
+	d.Set("description", stage.Description)
+	d.Set("cache_cluster_enabled", stage.CacheClusterEnabled)
+	d.Set("cache_cluster_size", stage.CacheClusterSize)
+	d.Set("client_certificate_id", stage.ClientCertificateId)
+	d.Set("documentation_version", stage.DocumentationVersion)
+	d.Set("variables", aws.StringValueMap(stage.Variables))
+	d.Set("tags", aws.StringValueMap(stage.Tags))
+
+	return nil
+}
+
+func resourceAwsApiGatewayStageUpdateOperations(d *schema.ResourceData) []*apigateway.PatchOperation {
+	operations := make([]*apigateway.PatchOperation, 0)
+
+	if d.HasChange("deployment_id") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/deploymentId"),
+			Value: aws.String(d.Get("deployment_id").(string)),
+		})
+	}
+
+	if d.HasChange("description") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/description"),
+			Value: aws.String(d.Get("description").(string)),
+		})
+	}
+
+	if d.HasChange("cache_cluster_enabled") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/cacheClusterEnabled"),
+			Value: aws.String(fmt.Sprintf("%t", d.Get("cache_cluster_enabled").(bool))),
+		})
+	}
+
+	if d.HasChange("cache_cluster_size") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/cacheClusterSize"),
+			Value: aws.String(d.Get("cache_cluster_size").(string)),
+		})
+	}
+
+	if d.HasChange("client_certificate_id") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/clientCertificateId"),
+			Value: aws.String(d.Get("client_certificate_id").(string)),
+		})
+	}
+
+	if d.HasChange("documentation_version") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/documentationVersion"),
+			Value: aws.String(d.Get("documentation_version").(string)),
+		})
+	}
+
+	if d.HasChange("variables") {
+		o, n := d.GetChange("variables")
+		operations = append(operations, diffApiGatewayStageMapOperations("/variables/%s", o, n)...)
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		operations = append(operations, diffApiGatewayStageMapOperations("/tags/%s", o, n)...)
+	}
+
+	return operations
+}
+
+func diffApiGatewayStageMapOperations(pathFormat string, o, n interface{}) []*apigateway.PatchOperation {
+	operations := make([]*apigateway.PatchOperation, 0)
+
+	oldV := o.(map[string]interface{})
+	newV := n.(map[string]interface{})
+
+	for k := range oldV {
+		if _, ok := newV[k]; !ok {
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:   aws.String("remove"),
+				Path: aws.String(fmt.Sprintf(pathFormat, k)),
+			})
+		}
+	}
+
+	for k, v := range newV {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String(fmt.Sprintf(pathFormat, k)),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return operations
+}
+
+func resourceAwsApiGatewayStageUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	log.Printf("[DEBUG] Updating API Gateway Stage: %s", d.Id())
+
+	_, err := conn.UpdateStage(&apigateway.UpdateStageInput{
+		RestApiId:       aws.String(d.Get("rest_api_id").(string)),
+		StageName:       aws.String(d.Get("stage_name").(string)),
+		PatchOperations: resourceAwsApiGatewayStageUpdateOperations(d),
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsApiGatewayStageRead(d, meta)
+}
+
+func resourceAwsApiGatewayStageDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+	log.Printf("[DEBUG] Deleting API Gateway Stage: %s", d.Id())
+
+	_, err := conn.DeleteStage(&apigateway.DeleteStageInput{
+		RestApiId: aws.String(d.Get("rest_api_id").(string)),
+		StageName: aws.String(d.Get("stage_name").(string)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NotFoundException" {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}